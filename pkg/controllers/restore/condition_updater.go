@@ -0,0 +1,56 @@
+// Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	restoreutil "github.com/oracle/mysql-operator/pkg/api/restore"
+	v1alpha1 "github.com/oracle/mysql-operator/pkg/apis/mysql/v1alpha1"
+	clientset "github.com/oracle/mysql-operator/pkg/generated/clientset/versioned/typed/mysql/v1alpha1"
+)
+
+// ConditionUpdater updates the conditions of a Restore, persisting the
+// change to the API server.
+type ConditionUpdater interface {
+	// Update sets condition on restore, clearing or transitioning any
+	// existing condition of the same type as required, and persists the
+	// change.
+	Update(ctx context.Context, restore *v1alpha1.Restore, condition *v1alpha1.RestoreCondition) error
+}
+
+type conditionUpdater struct {
+	client clientset.RestoresGetter
+}
+
+// Update sets condition on restore. Unlike a naive append, this transitions
+// any existing condition of the same type in place (updating its status,
+// reason, message, and transition time) so that a condition previously set
+// (e.g. RestoreFailed from a prior validation error) is cleared once it no
+// longer applies, rather than accumulating stale entries.
+func (u *conditionUpdater) Update(ctx context.Context, restore *v1alpha1.Restore, condition *v1alpha1.RestoreCondition) error {
+	if !restoreutil.UpdateRestoreCondition(&restore.Status, condition) {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
+	defer cancel()
+	_, err := u.client.Restores(restore.Namespace).Update(ctx, restore, metav1.UpdateOptions{})
+	return errors.Wrap(err, "failed to update Restore status")
+}
@@ -0,0 +1,122 @@
+// Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1alpha1 "github.com/oracle/mysql-operator/pkg/apis/mysql/v1alpha1"
+	kubeutil "github.com/oracle/mysql-operator/pkg/util/kube"
+)
+
+// restoreProtectionFinalizer is added to a Restore once it has been
+// scheduled onto an agent, and only removed once that agent has confirmed
+// it's no longer running (or was never started). This prevents deleting a
+// Restore mid-run from leaving the target Cluster in an inconsistent state
+// with no record of what was in flight.
+const restoreProtectionFinalizer = "mysql.oracle.com/restore-protection"
+
+// finalizeRestore handles a Restore with a non-nil DeletionTimestamp. If the
+// protection finalizer isn't present there's nothing to do -- the API
+// server will GC the object directly. Otherwise we check whether the
+// scheduled agent is still running the restore, ask it to abort if so, and
+// only remove the finalizer once it has confirmed it's no longer running.
+func (controller *OperatorController) finalizeRestore(ctx context.Context, restore *v1alpha1.Restore) error {
+	if !kubeutil.ContainsFinalizer(restore.ObjectMeta, restoreProtectionFinalizer) {
+		return nil
+	}
+
+	running, err := controller.isAgentRunningRestore(restore)
+	if err != nil {
+		return errors.Wrap(err, "error checking scheduled agent's restore status")
+	}
+	if running {
+		glog.V(2).Infof("Restore %q is still running on Pod %q, signaling abort",
+			kubeutil.NamespaceAndName(restore), restore.Spec.ScheduledMember)
+		if err := controller.abortAgentRestore(ctx, restore); err != nil {
+			return errors.Wrap(err, "error signaling agent to abort")
+		}
+		// Re-queue; we'll be called again once the agent clears its
+		// running marker (observed via the Pod informer) or on the next
+		// resync.
+		return errors.New("waiting for agent to abort in-flight restore")
+	}
+
+	kubeutil.RemoveFinalizer(&restore.ObjectMeta, restoreProtectionFinalizer)
+
+	updateCtx, cancel := context.WithTimeout(ctx, apiCallTimeout)
+	defer cancel()
+	_, err = controller.client.Restores(restore.Namespace).Update(updateCtx, restore, metav1.UpdateOptions{})
+	return errors.Wrap(err, "failed to remove finalizer")
+}
+
+// isAgentRunningRestore reports whether the agent Pod that this Restore was
+// scheduled on is still actively executing it, as recorded by the
+// mysql.oracle.com/restore-running annotation the agent writes on its own
+// Pod for the duration of the restore.
+func (controller *OperatorController) isAgentRunningRestore(restore *v1alpha1.Restore) (bool, error) {
+	if restore.Spec.ScheduledMember == "" {
+		return false, nil
+	}
+
+	pod, err := controller.podLister.Pods(restore.Namespace).Get(restore.Spec.ScheduledMember)
+	if apierrors.IsNotFound(err) {
+		// The agent Pod is gone; there's nothing left to abort.
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return pod.Annotations[agentRestoreRunningAnnotation] == restore.Name, nil
+}
+
+// abortAgentRestore signals the agent running restore to abort by writing
+// agentRestoreAbortAnnotation, naming the restore, onto the agent's Pod. The
+// running marker is left for the agent itself to clear; its own control loop
+// is responsible for observing the abort request and terminating the
+// mysqlbinlog/mysql processes it started.
+func (controller *OperatorController) abortAgentRestore(ctx context.Context, restore *v1alpha1.Restore) error {
+	pod, err := controller.podLister.Pods(restore.Namespace).Get(restore.Spec.ScheduledMember)
+	if err != nil {
+		return err
+	}
+
+	pod = pod.DeepCopy()
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[agentRestoreAbortAnnotation] = restore.Name
+
+	ctx, cancel := context.WithTimeout(ctx, apiCallTimeout)
+	defer cancel()
+	_, err = controller.kubeClient.CoreV1().Pods(pod.Namespace).Update(ctx, pod, metav1.UpdateOptions{})
+	return err
+}
+
+// agentRestoreRunningAnnotation is written by the agent onto its own Pod for
+// the duration of an in-flight restore, and cleared when it finishes or
+// aborts.
+const agentRestoreRunningAnnotation = "mysql.oracle.com/restore-running"
+
+// agentRestoreAbortAnnotation is written by the operator to ask the agent to
+// abort the named in-flight restore.
+const agentRestoreAbortAnnotation = "mysql.oracle.com/restore-abort"
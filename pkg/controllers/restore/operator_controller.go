@@ -16,6 +16,7 @@ package restore
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -24,6 +25,8 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
 	field "k8s.io/apimachinery/pkg/util/validation/field"
 	wait "k8s.io/apimachinery/pkg/util/wait"
 	corev1informers "k8s.io/client-go/informers/core/v1"
@@ -47,12 +50,53 @@ import (
 
 const controllerAgentName = "operator-restore-controller"
 
+// apiCallTimeout bounds how long a single call to the API server is allowed
+// to take, so a stalled apiserver can't wedge a worker indefinitely.
+const apiCallTimeout = 30 * time.Second
+
+// pausedAnnotation, when set to "true" on a Restore, tells the controller
+// to hold off reconciling it (short-circuiting before scheduling) without
+// requiring the object to be deleted. This mirrors the pause/resume pattern
+// used by MOCO's ConditionReconciliationActive.
+//
+// NOTE: surfacing RestoreReconciliationActive as an additionalPrinterColumn
+// on `kubectl get restore` requires editing the Restore CustomResourceDefinition
+// manifest, which isn't part of this package and isn't present in this
+// checkout -- that change needs to land alongside this one wherever that
+// manifest lives.
+const pausedAnnotation = "mysql.oracle.com/restore-paused"
+
+// isRestorePaused reports whether restore has been paused via
+// pausedAnnotation, along with a human-readable reason.
+func isRestorePaused(restore *v1alpha1.Restore) (bool, string) {
+	if restore.Annotations[pausedAnnotation] == "true" {
+		return true, fmt.Sprintf("annotation %q is set", pausedAnnotation)
+	}
+	return false, ""
+}
+
+// restoreScheduledMemberIndex indexes Restores by Spec.ScheduledMember, so
+// enqueueRestoresScheduledOnPod can look up the (usually zero or one)
+// Restores scheduled on a given Pod without listing every Restore in the
+// namespace.
+const restoreScheduledMemberIndex = "scheduledMember"
+
+func indexRestoresByScheduledMember(obj interface{}) ([]string, error) {
+	restore, ok := obj.(*v1alpha1.Restore)
+	if !ok || restore.Spec.ScheduledMember == "" {
+		return nil, nil
+	}
+	return []string{restore.Spec.ScheduledMember}, nil
+}
+
 // OperatorController handles validation, labeling, and scheduling of
 // Restores to be executed on a specific (primary) mysql-agent. It is run
 // in the operator.
 type OperatorController struct {
-	client      clientset.RestoresGetter
-	syncHandler func(key string) error
+	kubeClient kubernetes.Interface
+	client     clientset.RestoresGetter
+
+	syncHandler func(ctx context.Context, key string) error
 
 	// restoreLister is able to list/get Restores from a shared informer's
 	// store.
@@ -60,6 +104,11 @@ type OperatorController struct {
 	// restoreListerSynced returns true if the Restore shared informer has
 	// synced at least once.
 	restoreListerSynced cache.InformerSynced
+	// restoreIndexer is the same Restore informer's underlying indexer,
+	// used to look up the Restores scheduled on a given Pod by
+	// restoreScheduledMemberIndex in O(1) instead of listing and scanning
+	// every Restore in the namespace.
+	restoreIndexer cache.Indexer
 
 	// podLister is able to list/get Pods from a shared informer's store.
 	podLister corev1listers.PodLister
@@ -106,10 +155,18 @@ func NewOperatorController(
 	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
 	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: controllerAgentName})
 
+	if err := restoreInformer.Informer().AddIndexers(cache.Indexers{
+		restoreScheduledMemberIndex: indexRestoresByScheduledMember,
+	}); err != nil {
+		glog.Fatalf("Error adding Restore scheduledMember indexer: %v", err)
+	}
+
 	c := &OperatorController{
+		kubeClient:          kubeClient,
 		client:              client,
 		restoreLister:       restoreInformer.Lister(),
 		restoreListerSynced: restoreInformer.Informer().HasSynced,
+		restoreIndexer:      restoreInformer.Informer().GetIndexer(),
 		clusterLister:       clusterInformer.Lister(),
 		clusterListerSynced: clusterInformer.Informer().HasSynced,
 		backupLister:        backupInformer.Lister(),
@@ -125,29 +182,94 @@ func NewOperatorController(
 
 	restoreInformer.Informer().AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
-			AddFunc: func(obj interface{}) {
-				restore := obj.(*v1alpha1.Restore)
-
-				_, cond := restoreutil.GetRestoreCondition(&restore.Status, v1alpha1.RestoreScheduled)
-				if cond != nil && cond.Status == corev1.ConditionTrue {
-					glog.V(4).Infof("Restore %q is already scheduled on Cluster member %q",
-						kubeutil.NamespaceAndName(restore), restore.Spec.ScheduledMember)
-					return
-				}
-
-				key, err := cache.MetaNamespaceKeyFunc(restore)
-				if err != nil {
-					glog.Errorf("Error creating queue key, item not added to queue: %v", err)
-					return
-				}
-				c.queue.Add(key)
+			AddFunc: c.enqueueRestore,
+			UpdateFunc: func(old, new interface{}) {
+				// Always re-enqueue on update so that e.g. a Restore that
+				// failed validation gets a chance to be revalidated (and
+				// have its failure condition cleared) once its spec is
+				// fixed up, rather than requiring the user to delete and
+				// recreate it.
+				c.enqueueRestore(new)
 			},
+			DeleteFunc: c.enqueueRestore,
+		},
+	)
+
+	podInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(old, new interface{}) {
+				c.enqueueRestoresScheduledOnPod(new)
+			},
+			DeleteFunc: c.enqueueRestoresScheduledOnPod,
 		},
 	)
 
 	return c
 }
 
+// enqueueRestore adds the Restore to the work queue. It deliberately does
+// NOT skip Restores that are already scheduled: reconcileSchedule is the
+// one place that decides whether a scheduled Restore is a no-op (its Pod is
+// still healthy) or needs rescheduling (its Pod is gone), and it can only
+// make that call if it actually gets to run.
+func (controller *OperatorController) enqueueRestore(obj interface{}) {
+	restore, ok := obj.(*v1alpha1.Restore)
+	if !ok {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			restore, ok = tombstone.Obj.(*v1alpha1.Restore)
+			if !ok {
+				glog.Errorf("Tombstone contained object that is not a Restore: %#v", obj)
+				return
+			}
+		} else {
+			glog.Errorf("Unexpected object passed to enqueueRestore: %#v", obj)
+			return
+		}
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(restore)
+	if err != nil {
+		glog.Errorf("Error creating queue key, item not added to queue: %v", err)
+		return
+	}
+	controller.queue.Add(key)
+}
+
+// enqueueRestoresScheduledOnPod re-enqueues any Restore that was scheduled
+// onto pod, so that e.g. its agent crashing and the Pod going away triggers
+// a reconcile (and, via reconcileSchedule, a reschedule onto a new
+// candidate) instead of leaving the Restore stuck until the next spec edit.
+func (controller *OperatorController) enqueueRestoresScheduledOnPod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			pod, ok = tombstone.Obj.(*corev1.Pod)
+			if !ok {
+				glog.Errorf("Tombstone contained object that is not a Pod: %#v", obj)
+				return
+			}
+		} else {
+			glog.Errorf("Unexpected object passed to enqueueRestoresScheduledOnPod: %#v", obj)
+			return
+		}
+	}
+
+	objs, err := controller.restoreIndexer.ByIndex(restoreScheduledMemberIndex, pod.Name)
+	if err != nil {
+		glog.Errorf("Error looking up Restores scheduled on Pod %q: %v", kubeutil.NamespaceAndName(pod), err)
+		return
+	}
+	for _, obj := range objs {
+		restore, ok := obj.(*v1alpha1.Restore)
+		if !ok || restore.Namespace != pod.Namespace {
+			// The index is keyed by Pod name alone, which isn't unique
+			// across namespaces.
+			continue
+		}
+		controller.enqueueRestore(restore)
+	}
+}
+
 // Run is a blocking function that runs the specified number of worker
 // goroutines to process items in the work queue. It will return when it
 // receives on the stopCh channel.
@@ -185,7 +307,7 @@ func (controller *OperatorController) Run(ctx context.Context, numWorkers int) e
 	wg.Add(numWorkers)
 	for i := 0; i < numWorkers; i++ {
 		go func() {
-			wait.Until(controller.runWorker, time.Second, ctx.Done())
+			wait.Until(func() { controller.runWorker(ctx) }, time.Second, ctx.Done())
 			wg.Done()
 		}()
 	}
@@ -195,14 +317,14 @@ func (controller *OperatorController) Run(ctx context.Context, numWorkers int) e
 	return nil
 }
 
-func (controller *OperatorController) runWorker() {
+func (controller *OperatorController) runWorker(ctx context.Context) {
 	// Continually take items off the queue (waits if it's empty) until we get a
 	// shutdown signal from the queue.
-	for controller.processNextWorkItem() {
+	for controller.processNextWorkItem(ctx) {
 	}
 }
 
-func (controller *OperatorController) processNextWorkItem() bool {
+func (controller *OperatorController) processNextWorkItem(ctx context.Context) bool {
 	key, quit := controller.queue.Get()
 	if quit {
 		return false
@@ -211,7 +333,7 @@ func (controller *OperatorController) processNextWorkItem() bool {
 	// rate-limiting below.
 	defer controller.queue.Done(key)
 
-	err := controller.syncHandler(key.(string))
+	err := controller.syncHandler(ctx, key.(string))
 	if err == nil {
 		// If you had no error, tell the queue to stop tracking history for your
 		// key. This will reset things like failure counts for per-item rate
@@ -228,7 +350,13 @@ func (controller *OperatorController) processNextWorkItem() bool {
 	return true
 }
 
-func (controller *OperatorController) processRestore(key string) error {
+// processRestore reconciles a single Restore to convergence. Rather than
+// relying on "already scheduled? skip" shortcuts in the event handlers, it
+// runs a sequence of small, idempotent reconcile steps that each check
+// current state and decide what (if anything) to do -- so spec changes,
+// transient apiserver errors, and operator restarts are all handled the
+// same way, by reconciling again.
+func (controller *OperatorController) processRestore(ctx context.Context, key string) error {
 	ns, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
 		return errors.Wrap(err, "error splitting queue key")
@@ -236,56 +364,202 @@ func (controller *OperatorController) processRestore(key string) error {
 
 	// Get resource from store.
 	restore, err := controller.restoreLister.Restores(ns).Get(name)
+	if apierrors.IsNotFound(err) {
+		// The Restore was deleted and fully GC'd (no finalizer left to
+		// process) between being enqueued and now -- e.g. via
+		// enqueueRestoresScheduledOnPod re-enqueuing a Restore whose
+		// DeleteFunc already ran. Nothing left to do.
+		return nil
+	}
 	if err != nil {
 		return errors.Wrap(err, "error getting Restore")
 	}
 
 	// Don't modify items in the cache.
 	restore = restore.DeepCopy()
-	// Set defaults (incl. operator version label).
-	restore = restore.EnsureDefaults()
 
-	validationErr := restore.Validate()
+	if restore.DeletionTimestamp != nil {
+		return controller.finalizeRestore(ctx, restore)
+	}
+
+	restore = controller.reconcileDefaults(restore)
+
+	validationErr := controller.reconcileValidation(restore)
+
 	if validationErr == nil {
-		// If there are no basic validation errors check the referenced
-		// resources exist.
-		validationErrs := field.ErrorList{}
+		var errs field.ErrorList
 		fldPath := field.NewPath("spec")
 
-		// Check the referenced Cluster exists.
-		_, err := controller.clusterLister.Clusters(ns).Get(restore.Spec.Cluster.Name)
-		if err != nil {
-			if !apierrors.IsNotFound(err) {
-				return err
-			}
-			validationErrs = append(validationErrs,
-				field.NotFound(fldPath.Child("cluster").Child("name"), restore.Spec.Cluster.Name))
+		_, clusterErrs := controller.reconcileClusterRef(restore, fldPath)
+		errs = append(errs, clusterErrs...)
+
+		_, backupErrs := controller.reconcileBackupRef(restore, fldPath)
+		errs = append(errs, backupErrs...)
+
+		if len(errs) > 0 {
+			validationErr = errs.ToAggregate()
 		}
+	}
 
-		// Check the referenced Backup exists.
-		_, err = controller.backupLister.Backups(ns).Get(restore.Spec.Backup.Name)
-		if err != nil {
+	if validationErr != nil {
+		controller.recorder.Eventf(restore, corev1.EventTypeWarning, "FailedValidation", validationErr.Error())
+		return controller.reconcileStatus(ctx, ns, restore, validationErr, false)
+	}
+
+	restore, changed, scheduled, err := controller.reconcileSchedule(ctx, restore)
+	if err != nil {
+		return errors.Wrap(err, "failed to reconcile schedule")
+	}
+
+	if err := controller.reconcileStatus(ctx, ns, restore, nil, changed); err != nil {
+		return err
+	}
+
+	// Only emit the event once the schedule has actually been persisted --
+	// baseline recorded it after a successful Update, and emitting it
+	// earlier would report "Scheduled on Pod X" (and re-fire on every
+	// retry) for a schedule the API server never saved.
+	if scheduled {
+		controller.recorder.Eventf(restore, corev1.EventTypeNormal, "SuccessScheduled", "Scheduled on Pod %q", restore.Spec.ScheduledMember)
+	}
+
+	return nil
+}
+
+// reconcileDefaults ensures restore has its defaults set (incl. the
+// operator version label). It is a pure function of restore and is always
+// safe to re-run.
+func (controller *OperatorController) reconcileDefaults(restore *v1alpha1.Restore) *v1alpha1.Restore {
+	return restore.EnsureDefaults()
+}
+
+// reconcileValidation runs restore's own structural validation, independent
+// of any other state in the cluster.
+func (controller *OperatorController) reconcileValidation(restore *v1alpha1.Restore) error {
+	return restore.Validate()
+}
+
+// reconcileClusterRef checks that restore's referenced Cluster exists (and,
+// for RestoreSchedulingPolicySpecificMember, that the requested member does
+// too), returning the Cluster for use by later steps.
+func (controller *OperatorController) reconcileClusterRef(restore *v1alpha1.Restore, fldPath *field.Path) (*v1alpha1.Cluster, field.ErrorList) {
+	cluster, err := controller.clusterLister.Clusters(restore.Namespace).Get(restore.Spec.Cluster.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, field.ErrorList{field.InternalError(fldPath.Child("cluster").Child("name"), err)}
+		}
+		return nil, field.ErrorList{field.NotFound(fldPath.Child("cluster").Child("name"), restore.Spec.Cluster.Name)}
+	}
+
+	if restore.Spec.SchedulingPolicy.Type == v1alpha1.RestoreSchedulingPolicySpecificMember {
+		memberName := restore.Spec.SchedulingPolicy.MemberName
+		if _, err := controller.getClusterMemberPod(restore.Namespace, restore.Spec.Cluster.Name, memberName); err != nil {
 			if !apierrors.IsNotFound(err) {
-				return err
+				return cluster, field.ErrorList{field.InternalError(fldPath.Child("schedulingPolicy").Child("memberName"), err)}
 			}
-			validationErrs = append(validationErrs,
-				field.NotFound(fldPath.Child("backup").Child("name"), restore.Spec.Backup.Name))
+			return cluster, field.ErrorList{field.NotFound(fldPath.Child("schedulingPolicy").Child("memberName"), memberName)}
 		}
-		if len(validationErrs) > 0 {
-			validationErr = validationErrs.ToAggregate()
+	}
+
+	return cluster, nil
+}
+
+// getClusterMemberPod looks up the named Pod and confirms it actually
+// belongs to the named Cluster, returning an apierrors.IsNotFound error
+// (mirroring podLister.Get) if it doesn't -- a bare podLister.Get(memberName)
+// would happily return a same-named Pod belonging to an unrelated Cluster in
+// the same namespace.
+func (controller *OperatorController) getClusterMemberPod(ns, clusterName, memberName string) (*corev1.Pod, error) {
+	pod, err := controller.podLister.Pods(ns).Get(memberName)
+	if err != nil {
+		return nil, err
+	}
+	if !clusterlabeler.ClusterSelector(clusterName).Matches(labels.Set(pod.Labels)) {
+		return nil, apierrors.NewNotFound(corev1.Resource("pods"), memberName)
+	}
+	return pod, nil
+}
+
+// reconcileBackupRef checks that restore's referenced Backup exists, and
+// (when restore.Spec.PointInTime is set) that it can satisfy the requested
+// point-in-time target.
+func (controller *OperatorController) reconcileBackupRef(restore *v1alpha1.Restore, fldPath *field.Path) (*v1alpha1.Backup, field.ErrorList) {
+	backup, err := controller.backupLister.Backups(restore.Namespace).Get(restore.Spec.Backup.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, field.ErrorList{field.InternalError(fldPath.Child("backup").Child("name"), err)}
+		}
+		return nil, field.ErrorList{field.NotFound(fldPath.Child("backup").Child("name"), restore.Spec.Backup.Name)}
+	}
+
+	if restore.Spec.PointInTime != nil {
+		if errs := validatePointInTime(restore.Spec.PointInTime, backup, fldPath.Child("pointInTime")); len(errs) > 0 {
+			return backup, errs
 		}
 	}
 
-	// If the Restore is not valid emit an event to that effect and mark
-	// it as failed.
-	// TODO(apryde): Maybe we should add an UpdateFunc to the restoreInformer
-	// and support users fixing validation errors via updates (rather than
-	// recreation).
+	return backup, nil
+}
+
+// reconcileSchedule is the idempotent core of scheduling: if restore is
+// already scheduled onto a healthy Pod it's a no-op; if the Pod it was
+// scheduled on is gone (e.g. a crashed agent) it goes on to reschedule the
+// Restore onto a new candidate below, overwriting the stale schedule; if that
+// Pod exists but is not Ready it returns an error (so the Restore is
+// retried) without rescheduling; otherwise it
+// honors a pause request or schedules it. It reports whether restore.Status
+// or restore.Spec changed (so the caller knows whether a persist is needed)
+// and whether a new schedule was made (so the caller can emit the
+// SuccessScheduled event once that schedule has actually been persisted).
+func (controller *OperatorController) reconcileSchedule(ctx context.Context, restore *v1alpha1.Restore) (*v1alpha1.Restore, bool, bool, error) {
+	if _, cond := restoreutil.GetRestoreCondition(&restore.Status, v1alpha1.RestoreScheduled); cond != nil && cond.Status == corev1.ConditionTrue {
+		pod, err := controller.podLister.Pods(restore.Namespace).Get(restore.Spec.ScheduledMember)
+		switch {
+		case err == nil && kubeutil.IsPodReady(pod):
+			return restore, false, false, nil
+		case apierrors.IsNotFound(err):
+			glog.V(2).Infof("Scheduled Pod %q for Restore %q no longer exists, rescheduling",
+				restore.Spec.ScheduledMember, kubeutil.NamespaceAndName(restore))
+		case err != nil:
+			return restore, false, false, err
+		default:
+			return restore, false, false, errors.Errorf("scheduled Pod %q is not Ready", restore.Spec.ScheduledMember)
+		}
+	}
+
+	if paused, reason := isRestorePaused(restore); paused {
+		glog.V(4).Infof("Restore %q is paused: %s", kubeutil.NamespaceAndName(restore), reason)
+		changed := restoreutil.UpdateRestoreCondition(&restore.Status, &v1alpha1.RestoreCondition{
+			Type:    v1alpha1.RestoreReconciliationActive,
+			Status:  corev1.ConditionFalse,
+			Reason:  "Paused",
+			Message: reason,
+		})
+		return restore, changed, false, nil
+	}
+	unpaused := restoreutil.UpdateRestoreCondition(&restore.Status, &v1alpha1.RestoreCondition{
+		Type:   v1alpha1.RestoreReconciliationActive,
+		Status: corev1.ConditionTrue,
+		Reason: "Unpaused",
+	})
+
+	restore, err := controller.scheduleRestore(ctx, restore)
+	if err != nil {
+		return restore, unpaused, false, errors.Wrap(err, "failed to schedule")
+	}
+
+	return restore, true, true, nil
+}
+
+// reconcileStatus persists the outcome of the earlier reconcile steps:
+// recording (or clearing) the RestoreFailed condition, and, if changed,
+// persisting restore itself.
+func (controller *OperatorController) reconcileStatus(ctx context.Context, ns string, restore *v1alpha1.Restore, validationErr error, changed bool) error {
 	if validationErr != nil {
-		controller.recorder.Eventf(restore, corev1.EventTypeWarning, "FailedValidation", validationErr.Error())
-		// NOTE: We only return an error here if we fail to set the condition
-		// (rather than on validation failure) as we don't want to retry.
-		return controller.conditionUpdater.Update(restore, &v1alpha1.RestoreCondition{
+		// NOTE: We only return an error here if we fail to set the
+		// condition (rather than on validation failure) as we don't want
+		// to retry.
+		return controller.conditionUpdater.Update(ctx, restore, &v1alpha1.RestoreCondition{
 			Type:    v1alpha1.RestoreFailed,
 			Status:  corev1.ConditionFalse,
 			Reason:  "FailedValidation",
@@ -293,42 +567,97 @@ func (controller *OperatorController) processRestore(key string) error {
 		})
 	}
 
-	// Schedule restore on a primary.
-	restore, err = controller.scheduleRestore(restore)
-	if err != nil {
-		return errors.Wrap(err, "failed to schedule")
+	// Revalidation succeeded. If a previous attempt had left a failed
+	// condition behind, clear it in memory (so that e.g. `kubectl get
+	// restore` stops reporting a validation error that's since been fixed)
+	// and fold the change into the single persist below, rather than
+	// issuing a separate Update here: conditionUpdater.Update discards the
+	// object the server hands back, so a second Update immediately after
+	// would carry a stale ResourceVersion and fail with a 409 Conflict.
+	if _, cond := restoreutil.GetRestoreCondition(&restore.Status, v1alpha1.RestoreFailed); cond != nil && cond.Reason == "FailedValidation" {
+		if restoreutil.UpdateRestoreCondition(&restore.Status, &v1alpha1.RestoreCondition{
+			Type:   v1alpha1.RestoreFailed,
+			Status: corev1.ConditionFalse,
+			Reason: "ValidationSucceeded",
+		}) {
+			changed = true
+		}
 	}
 
-	// Update resource.
-	restore, err = controller.client.Restores(ns).Update(restore)
-	if err != nil {
-		return errors.Wrap(err, "failed to update")
+	if !changed {
+		return nil
 	}
 
-	controller.recorder.Eventf(restore, corev1.EventTypeNormal, "SuccessScheduled", "Scheduled on Pod %q", restore.Spec.ScheduledMember)
-
-	return nil
+	updateCtx, cancel := context.WithTimeout(ctx, apiCallTimeout)
+	defer cancel()
+	_, err := controller.client.Restores(ns).Update(updateCtx, restore, metav1.UpdateOptions{})
+	return errors.Wrap(err, "failed to update")
 }
 
 // scheduleRestore schedules a Restore on a specific member of a Cluster.
-func (controller *OperatorController) scheduleRestore(restore *v1alpha1.Restore) (*v1alpha1.Restore, error) {
+// When restore.Spec.PointInTime is set (and has already passed validation
+// in processRestore) it is carried through on restore.Spec unchanged, so the
+// scheduled agent can restore the full dump, fetch binlogs from the
+// configured BinlogSource, and replay up to the target GTID/timestamp.
+func (controller *OperatorController) scheduleRestore(ctx context.Context, restore *v1alpha1.Restore) (*v1alpha1.Restore, error) {
+	member, err := controller.selectSchedulingCandidate(restore)
+	if err != nil {
+		return nil, err
+	}
+	if !kubeutil.IsPodReady(member) {
+		return nil, errors.Errorf("candidate Pod %q is not Ready", member.Name)
+	}
+
+	restoreutil.UpdateRestoreCondition(&restore.Status, &v1alpha1.RestoreCondition{
+		Type:   v1alpha1.RestoreScheduled,
+		Status: corev1.ConditionTrue,
+	})
+	restore.Spec.ScheduledMember = member.Name
+	kubeutil.AddFinalizer(&restore.ObjectMeta, restoreProtectionFinalizer)
+	return restore, nil
+}
+
+// selectSchedulingCandidate picks the Pod a Restore should be scheduled on,
+// according to restore.Spec.SchedulingPolicy. Primary (the default) picks
+// the first primary Pod; PreferSecondary picks the first secondary Pod (to
+// offload large logical restores to a replica the user intends to promote
+// afterwards), falling back to the primary when there is none; SpecificMember
+// picks the named member, letting multiple concurrent Restores be spread
+// across members explicitly.
+func (controller *OperatorController) selectSchedulingCandidate(restore *v1alpha1.Restore) (*corev1.Pod, error) {
 	var (
 		name = restore.Spec.Cluster.Name
 		ns   = restore.Namespace
 	)
 
-	primaries, err := controller.podLister.Pods(ns).List(clusterlabeler.PrimarySelector(name))
-	if err != nil {
-		return restore, errors.Wrap(err, "error listing Pods")
-	}
-	if len(primaries) > 0 {
-		restoreutil.UpdateRestoreCondition(&restore.Status, &v1alpha1.RestoreCondition{
-			Type:   v1alpha1.RestoreScheduled,
-			Status: corev1.ConditionTrue,
-		})
-		restore.Spec.ScheduledMember = primaries[0].Name
-		return restore, nil
-	}
+	switch restore.Spec.SchedulingPolicy.Type {
+	case v1alpha1.RestoreSchedulingPolicySpecificMember:
+		memberName := restore.Spec.SchedulingPolicy.MemberName
+		pod, err := controller.getClusterMemberPod(ns, name, memberName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting member Pod %q", memberName)
+		}
+		return pod, nil
 
-	return nil, errors.New("no primaries found")
+	case v1alpha1.RestoreSchedulingPolicyPreferSecondary:
+		secondaries, err := controller.podLister.Pods(ns).List(clusterlabeler.SecondarySelector(name))
+		if err != nil {
+			return nil, errors.Wrap(err, "error listing secondary Pods")
+		}
+		if len(secondaries) > 0 {
+			return secondaries[0], nil
+		}
+		// Fall through to the primary if there's no secondary to offload to.
+		fallthrough
+
+	default: // v1alpha1.RestoreSchedulingPolicyPrimary
+		primaries, err := controller.podLister.Pods(ns).List(clusterlabeler.PrimarySelector(name))
+		if err != nil {
+			return nil, errors.Wrap(err, "error listing primary Pods")
+		}
+		if len(primaries) == 0 {
+			return nil, errors.New("no primaries found")
+		}
+		return primaries[0], nil
+	}
 }
@@ -0,0 +1,50 @@
+// Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	field "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1alpha1 "github.com/oracle/mysql-operator/pkg/apis/mysql/v1alpha1"
+)
+
+// validatePointInTime checks that pit is satisfiable by backup: the backup
+// must have completed at or before the target time, and pit must name a
+// BinlogSource that covers the gap between the backup and the target. The
+// agent uses pit to replay binlogs with `mysqlbinlog --stop-datetime=...`
+// after restoring the full dump.
+func validatePointInTime(pit *v1alpha1.PointInTime, backup *v1alpha1.Backup, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if backup.Status.CompletionTime == nil {
+		errs = append(errs, field.Invalid(fldPath, pit.Time, "referenced Backup has not completed"))
+		return errs
+	}
+	if backup.Status.CompletionTime.Time.After(pit.Time.Time) {
+		errs = append(errs, field.Invalid(fldPath.Child("time"), pit.Time,
+			"must not be before the referenced Backup's completion time"))
+		return errs
+	}
+
+	if pit.BinlogSource == nil {
+		errs = append(errs, field.Required(fldPath.Child("binlogSource"),
+			"required to replay binlogs between the Backup and the target time"))
+	} else if !pit.BinlogSource.Covers(backup.Status.CompletionTime.Time, pit.Time.Time) {
+		errs = append(errs, field.Invalid(fldPath.Child("binlogSource"), pit.BinlogSource.Prefix,
+			"does not cover the gap between the Backup's completion time and the target time"))
+	}
+
+	return errs
+}